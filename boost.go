@@ -0,0 +1,108 @@
+package glocko2
+
+import (
+	"math"
+)
+
+// BoostConfig carries the tunable parameters of the Glicko-Boost
+// variant, as used in Glickman's Kaggle chess-rating competition entry.
+type BoostConfig struct {
+	// Eta is the advantage of playing first (e.g., White in chess),
+	// expressed in rating points. It is scaled by the same factor as R
+	// and Rd before being folded into the expectation.
+	Eta float64
+	// B1 inflates the pre-period RD when a player over-performs their
+	// expectation by more than K standard deviations.
+	B1 float64
+	// B2 inflates the pre-period RD when a player under-performs their
+	// expectation by more than K standard deviations.
+	B2 float64
+	// K is the number of standard deviations a player's actual score may
+	// deviate from their expected score before the B1/B2 boost applies.
+	K float64
+	// Alpha0..Alpha4 are the RD decay coefficients applied to idle
+	// players, chosen by rating bucket (lowest to highest rated).
+	Alpha0 float64
+	Alpha1 float64
+	Alpha2 float64
+	Alpha3 float64
+	Alpha4 float64
+}
+
+// scaleOpponentsBoost mirrors scaleOpponents, but folds the per-game
+// Advantage into the expectation via eta before calling e().
+func scaleOpponentsBoost(mu float64, os []Opponent, players []Player, eta float64) (res []opp) {
+	res = make([]opp, len(os))
+	for i, o := range os {
+		muj, phij := Scale(players[o.Idx].R, players[o.Idx].Rd)
+		adjmu := mu - eta*o.Advantage
+		res[i] = opp{muj, phij, g(phij), e(adjmu, muj, phij), o.Sj}
+	}
+
+	return res
+}
+
+// alphaForRating picks the idle-decay coefficient for a player's current
+// rating bucket, per the Glicko-Boost scheme of decaying lower-rated
+// (more volatile) players faster than established ones.
+func alphaForRating(r float64, cfg BoostConfig) float64 {
+	switch {
+	case r < 1200:
+		return cfg.Alpha0
+	case r < 1500:
+		return cfg.Alpha1
+	case r < 1800:
+		return cfg.Alpha2
+	case r < 2100:
+		return cfg.Alpha3
+	default:
+		return cfg.Alpha4
+	}
+}
+
+// PhiStarBoost computes the rating deviation for an idle player under
+// Glicko-Boost, using the decay coefficient for their rating bucket
+// instead of the single sigma' used by plain PhiStar.
+func PhiStarBoost(phi float64, rating float64, cfg BoostConfig) float64 {
+	alpha := alphaForRating(rating, cfg)
+	return math.Sqrt(phi*phi + alpha*alpha)
+}
+
+// RankBoost computes the new rank of player p using the Glicko-Boost
+// variant: the expectation accounts for first-move advantage via
+// cfg.Eta, and the pre-period RD is inflated by cfg.B1 or cfg.B2 when
+// the player's actual score deviates from their expected score by more
+// than cfg.K standard deviations.
+//
+// Requires a database of players and a list of matches in opponents
+func (p *Player) RankBoost(opponents []Opponent, players []Player, tau float64, cfg BoostConfig) (float64, float64, float64) {
+	mu, phi := Scale(p.R, p.Rd)
+	eta := cfg.Eta / scaling
+	sopps := scaleOpponentsBoost(mu, opponents, players, eta)
+
+	v := updateRating(sopps)
+
+	actual, expected, variance := 0.0, 0.0, 0.0
+	for _, o := range sopps {
+		actual += o.sj
+		expected += o.emmp
+		variance += o.emmp * (1 - o.emmp)
+	}
+	z := (actual - expected) / math.Sqrt(variance)
+
+	boostedPhi := phi
+	switch {
+	case z > cfg.K:
+		boostedPhi = phi * cfg.B1
+	case z < -cfg.K:
+		boostedPhi = phi * cfg.B2
+	}
+
+	delta := computeDelta(v, sopps)
+	sigmap := computeVolatility(p.Sigma, boostedPhi, v, delta, tau)
+	phistar := PhiStar(sigmap, boostedPhi)
+	mup, phip := newRating(phistar, mu, v, sopps)
+	r1, rd1 := Unscale(mup, phip)
+
+	return r1, rd1, sigmap
+}
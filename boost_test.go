@@ -0,0 +1,118 @@
+package glocko2
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRankBoostNoAdvantageMatchesRank(t *testing.T) {
+	// With Eta = 0 and a K threshold no result can cross, RankBoost must
+	// reduce to the plain Glicko-2 update from the reference example in
+	// Glickman's paper.
+	const expectR, expectRd, expectSigma = 1464.0506705393013, 151.51652412385727, 0.059995984286488495
+
+	p := Player{Id: "p", R: 1500, Rd: 200, Sigma: 0.06}
+	players := []Player{
+		{Id: "a", R: 1400, Rd: 30},
+		{Id: "b", R: 1550, Rd: 100},
+		{Id: "c", R: 1700, Rd: 300},
+	}
+	opponents := []Opponent{
+		{Idx: 0, Sj: 1.0},
+		{Idx: 1, Sj: 0.0},
+		{Idx: 2, Sj: 0.0},
+	}
+	cfg := BoostConfig{Eta: 0, B1: 1, B2: 1, K: 999}
+
+	r1, rd1, sigmap := p.RankBoost(opponents, players, 0.5, cfg)
+
+	if math.Abs(r1-expectR) > epsilon {
+		t.Errorf("RankBoost r1 = %v, want %v", r1, expectR)
+	}
+	if math.Abs(rd1-expectRd) > epsilon {
+		t.Errorf("RankBoost rd1 = %v, want %v", rd1, expectRd)
+	}
+	if math.Abs(sigmap-expectSigma) > epsilon {
+		t.Errorf("RankBoost sigmap = %v, want %v", sigmap, expectSigma)
+	}
+}
+
+func TestRankBoostAdvantageAdjustsExpectation(t *testing.T) {
+	// scaleOpponentsBoost must fold Eta*Advantage into (mu - muj) before
+	// calling e(), per the Glicko-Boost expectation formula.
+	const eta, expectE = 100.0 / scaling, 0.38083455299043123
+
+	mu, _ := Scale(1500, 200)
+	players := []Player{{Id: "o", R: 1500, Rd: 200}}
+	sopps := scaleOpponentsBoost(mu, []Opponent{{Idx: 0, Sj: 1.0, Advantage: 1}}, players, eta)
+
+	if math.Abs(sopps[0].emmp-expectE) > epsilon {
+		t.Errorf("scaleOpponentsBoost emmp = %v, want %v", sopps[0].emmp, expectE)
+	}
+}
+
+func TestRankBoostReferenceWithAdvantage(t *testing.T) {
+	// A worked example with a nonzero Eta and real Advantage, so the
+	// advantage term is actually exercised end to end rather than just
+	// the Eta=0 no-op path.
+	const expectR, expectRd, expectSigma = 1598.6556857770988, 181.0657160500912, 0.06000061011472179
+
+	p := Player{Id: "p", R: 1500, Rd: 200, Sigma: 0.06}
+	players := []Player{{Id: "o", R: 1500, Rd: 200, Sigma: 0.06}}
+	opponents := []Opponent{{Idx: 0, Sj: 1.0, Advantage: 1}}
+	cfg := BoostConfig{Eta: 100, B1: 1, B2: 1, K: 999}
+
+	r1, rd1, sigmap := p.RankBoost(opponents, players, 0.5, cfg)
+
+	if math.Abs(r1-expectR) > epsilon {
+		t.Errorf("RankBoost r1 = %v, want %v", r1, expectR)
+	}
+	if math.Abs(rd1-expectRd) > epsilon {
+		t.Errorf("RankBoost rd1 = %v, want %v", rd1, expectRd)
+	}
+	if math.Abs(sigmap-expectSigma) > epsilon {
+		t.Errorf("RankBoost sigmap = %v, want %v", sigmap, expectSigma)
+	}
+}
+
+func TestRankBoostInflatesRdOnOverperformance(t *testing.T) {
+	p := Player{Id: "p", R: 1500, Rd: 200, Sigma: 0.06}
+	players := []Player{
+		{Id: "a", R: 1400, Rd: 30},
+		{Id: "b", R: 1550, Rd: 100},
+		{Id: "c", R: 1700, Rd: 300},
+	}
+	opponents := []Opponent{
+		{Idx: 0, Sj: 1.0},
+		{Idx: 1, Sj: 1.0},
+		{Idx: 2, Sj: 1.0},
+	}
+
+	base := BoostConfig{Eta: 0, B1: 1, B2: 1, K: 999}
+	_, rdBase, _ := p.RankBoost(opponents, players, 0.5, base)
+
+	boosted := BoostConfig{Eta: 0, B1: 2, B2: 1, K: 0}
+	_, rdBoosted, _ := p.RankBoost(opponents, players, 0.5, boosted)
+
+	if rdBoosted <= rdBase {
+		t.Errorf("RankBoost with B1 boost triggered = %v, want > unboosted %v", rdBoosted, rdBase)
+	}
+}
+
+func TestPhiStarBoostBuckets(t *testing.T) {
+	cfg := BoostConfig{Alpha0: 0.5, Alpha1: 0.4, Alpha2: 0.3, Alpha3: 0.2, Alpha4: 0.1}
+
+	if a := alphaForRating(1000, cfg); a != cfg.Alpha0 {
+		t.Errorf("alphaForRating(1000) = %v, want %v", a, cfg.Alpha0)
+	}
+	if a := alphaForRating(2200, cfg); a != cfg.Alpha4 {
+		t.Errorf("alphaForRating(2200) = %v, want %v", a, cfg.Alpha4)
+	}
+
+	phi := 1.0
+	got := PhiStarBoost(phi, 2200, cfg)
+	want := math.Sqrt(phi*phi + cfg.Alpha4*cfg.Alpha4)
+	if math.Abs(got-want) > epsilon {
+		t.Errorf("PhiStarBoost(%v, 2200, ⋯) = %v, want %v", phi, got, want)
+	}
+}
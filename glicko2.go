@@ -27,14 +27,39 @@ type Player struct {
 	Sigma float64
 	// True if the player is currently actively playing games
 	Active bool
+	// Number of rated games the player has completed. Used to decide
+	// whether Rank should still treat the player as provisional.
+	Games int
 }
 
+const (
+	// DefaultProvisionalRdThreshold is the RD above which a player is
+	// still considered provisional.
+	DefaultProvisionalRdThreshold = 350.0
+	// DefaultProvisionalGames is the number of rated games below which a
+	// player is still considered provisional.
+	DefaultProvisionalGames = 20
+)
+
+var (
+	// ProvisionalRdThreshold is the configurable RD cutoff used by Rank
+	// to decide whether a player is still provisional.
+	ProvisionalRdThreshold = DefaultProvisionalRdThreshold
+	// ProvisionalGames is the configurable games-played cutoff used by
+	// Rank to decide whether a player is still provisional.
+	ProvisionalGames = DefaultProvisionalGames
+)
+
 // Opponent represents an opponent for the player
 type Opponent struct {
 	// Player index into an []Player array
 	Idx int
 	// Match score
 	Sj float64
+	// Advantage the player had in this particular game, e.g., +1 if the
+	// player had white, -1 if black, and 0 if the game carries no
+	// positional advantage. Only consulted by RankBoost.
+	Advantage float64
 }
 
 type opp struct {
@@ -193,7 +218,16 @@ func Unscale(mup float64, phip float64) (float64, float64) {
 // Rank computes the new rank of player p
 //
 // Requires a database of players and a list of matches in opponents
+//
+// While p is provisional — Rd above ProvisionalRdThreshold, or fewer
+// than ProvisionalGames recorded — Rank skips the volatility
+// root-finder entirely and instead applies a simple Elo-style K-factor
+// update, so cold-start accounts settle quickly without paying for
+// computeVolatility's iterative root search on ratings nobody trusts yet.
 func (p *Player) Rank(opponents []Opponent, players []Player, tau float64) (float64, float64, float64) {
+	if p.Rd > ProvisionalRdThreshold || p.Games < ProvisionalGames {
+		return p.rankProvisional(opponents, players)
+	}
 
 	mu, phi := Scale(p.R, p.Rd)
 	sopps := scaleOpponents(mu, opponents, players)
@@ -207,3 +241,67 @@ func (p *Player) Rank(opponents []Opponent, players []Player, tau float64) (floa
 
 	return r1, rd1, sigmap
 }
+
+// rankProvisional implements the K-factor fast path used by Rank for
+// cold-start players. K scales with the player's current Rd, so a wide-
+// open rating moves fast and narrows toward the full Glicko-2 treatment
+// as the player accumulates games.
+func (p *Player) rankProvisional(opponents []Opponent, players []Player) (float64, float64, float64) {
+	mu, _ := Scale(p.R, p.Rd)
+	sopps := scaleOpponents(mu, opponents, players)
+
+	actual, expected := 0.0, 0.0
+	for _, o := range sopps {
+		actual += o.sj
+		expected += o.emmp
+	}
+
+	const (
+		kFactorScale  = 0.1  // K = Rd * kFactorScale
+		rdDecayFactor = 0.98 // Rd shrinks by this factor per game played
+	)
+	k := p.Rd * kFactorScale
+	r1 := p.R + k*(actual-expected)
+	rd1 := p.Rd * math.Pow(rdDecayFactor, float64(len(sopps)))
+
+	return r1, rd1, p.Sigma
+}
+
+// WinProbability computes the probability that player a beats player b,
+// folding in the rating uncertainty of both players by combining their
+// RDs as phi = sqrt(phi_a^2 + phi_b^2).
+func WinProbability(a Player, b Player) float64 {
+	mua, phia := Scale(a.R, a.Rd)
+	mub, phib := Scale(b.R, b.Rd)
+	phi := math.Sqrt(phia*phia + phib*phib)
+
+	return e(mua, mub, phi)
+}
+
+// MatchQuality estimates how even a match between a and b is likely to
+// be, on a scale from 0 (a foregone conclusion) to 1 (a toss-up).
+func MatchQuality(a Player, b Player) float64 {
+	p := WinProbability(a, b)
+	return 1 - 2*math.Abs(p-0.5)
+}
+
+// Interval returns the confidence band [low, high] around p's rating,
+// p.R ± z*p.Rd. z=1.96 gives the standard 95% confidence interval.
+func (p Player) Interval(z float64) (low float64, high float64) {
+	return p.R - z*p.Rd, p.R + z*p.Rd
+}
+
+// Percentile returns the fraction of players that p is likely to
+// outperform, computed as the mean WinProbability of p against every
+// member of players.
+func Percentile(players []Player, p Player) float64 {
+	if len(players) == 0 {
+		return 0
+	}
+
+	sum := 0.0
+	for _, opponent := range players {
+		sum += WinProbability(p, opponent)
+	}
+	return sum / float64(len(players))
+}
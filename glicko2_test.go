@@ -22,14 +22,22 @@ func TestScale(t *testing.T) {
 	}
 }
 
+var referencePlayers = []Player{
+	{Id: "a", R: 1400, Rd: 30},
+	{Id: "b", R: 1550, Rd: 100},
+	{Id: "c", R: 1700, Rd: 300},
+}
+
+var referenceOpponents = []Opponent{
+	{Idx: 0, Sj: 1},
+	{Idx: 1, Sj: 0},
+	{Idx: 2, Sj: 0},
+}
+
 func TestScaleOpponents(t *testing.T) {
 	mu := 0.0
-	os := []Opponent{
-		Opponent{1400, 30, 1},
-		Opponent{1550, 100, 0},
-		Opponent{1700, 300, 0}}
 
-	scaled := scaleOpponents(mu, os)
+	scaled := scaleOpponents(mu, referenceOpponents, referencePlayers)
 
 	if scaled[0].muj != -0.5756462492617337 ||
 		scaled[0].phij != 0.1726938747785201 ||
@@ -58,12 +66,8 @@ func TestScaleOpponents(t *testing.T) {
 
 func TestUpdateRating(t *testing.T) {
 	const expect, mu = 1.7789770897239976, 0.0
-	os := []Opponent{
-		Opponent{1400, 30, 1},
-		Opponent{1550, 100, 0},
-		Opponent{1700, 300, 0}}
 
-	scaled := scaleOpponents(mu, os)
+	scaled := scaleOpponents(mu, referenceOpponents, referencePlayers)
 
 	v := updateRating(scaled)
 
@@ -74,12 +78,8 @@ func TestUpdateRating(t *testing.T) {
 
 func TestComputeDelta(t *testing.T) {
 	const expect, mu, v = -0.4839332609836549, 0.0, 1.7789770897239976
-	os := []Opponent{
-		Opponent{1400, 30, 1},
-		Opponent{1550, 100, 0},
-		Opponent{1700, 300, 0}}
 
-	scaled := scaleOpponents(mu, os)
+	scaled := scaleOpponents(mu, referenceOpponents, referencePlayers)
 
 	delta := computeDelta(v, scaled)
 
@@ -101,22 +101,17 @@ func TestComputeVolatility(t *testing.T) {
 func TestPhiStar(t *testing.T) {
 	const expect, sigmap, phi = 1.1528546895801364, 0.059995984286488495, 1.1512924985234674
 
-	phistar := phiStar(sigmap, phi)
+	phistar := PhiStar(sigmap, phi)
 
 	if math.Abs(phistar-expect) > epsilon {
-		t.Errorf("phiStar(⋯) = %v, want %v", phistar, expect)
+		t.Errorf("PhiStar(⋯) = %v, want %v", phistar, expect)
 	}
 }
 
 func TestNewRating(t *testing.T) {
 	const phistar, mu, v = 1.1528546895801364, 0.0, 1.7789770897239976
 
-	os := []Opponent{
-		Opponent{1400, 30, 1},
-		Opponent{1550, 100, 0},
-		Opponent{1700, 300, 0}}
-
-	scaled := scaleOpponents(mu, os)
+	scaled := scaleOpponents(mu, referenceOpponents, referencePlayers)
 
 	mup, phip := newRating(phistar, mu, v, scaled)
 
@@ -144,14 +139,68 @@ func TestUnscale(t *testing.T) {
 	}
 }
 
-func BenchmarkRate(b *testing.B) {
-	p := Player{1500, 200, 0.06}
-	os := []Opponent{
-		Opponent{1400, 30, 1},
-		Opponent{1550, 100, 0},
-		Opponent{1700, 300, 0}}
+func BenchmarkRank(b *testing.B) {
+	p := Player{Id: "p", R: 1500, Rd: 200, Sigma: 0.06, Games: DefaultProvisionalGames}
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		p.Rate(os)
+		p.Rank(referenceOpponents, referencePlayers, 0.5)
+	}
+}
+
+func TestWinProbability(t *testing.T) {
+	a := Player{Id: "a", R: 1500, Rd: 30, Sigma: 0.06}
+	b := Player{Id: "b", R: 1500, Rd: 30, Sigma: 0.06}
+
+	p := WinProbability(a, b)
+	if math.Abs(p-0.5) > epsilon {
+		t.Errorf("WinProbability(a, b) = %v, want 0.5 for equal players", p)
+	}
+
+	strong := Player{Id: "strong", R: 1700, Rd: 30, Sigma: 0.06}
+	if q := WinProbability(strong, b); q <= 0.5 {
+		t.Errorf("WinProbability(strong, b) = %v, want > 0.5", q)
+	}
+}
+
+func TestMatchQuality(t *testing.T) {
+	a := Player{Id: "a", R: 1500, Rd: 30, Sigma: 0.06}
+	b := Player{Id: "b", R: 1500, Rd: 30, Sigma: 0.06}
+
+	if q := MatchQuality(a, b); math.Abs(q-1.0) > epsilon {
+		t.Errorf("MatchQuality(a, b) = %v, want 1.0 for an even match", q)
+	}
+
+	strong := Player{Id: "strong", R: 2200, Rd: 30, Sigma: 0.06}
+	if q := MatchQuality(strong, b); q >= 0.5 {
+		t.Errorf("MatchQuality(strong, b) = %v, want a low quality for a lopsided match", q)
+	}
+}
+
+func TestInterval(t *testing.T) {
+	p := Player{Id: "p", R: 1500, Rd: 100, Sigma: 0.06}
+
+	low, high := p.Interval(1.96)
+	if math.Abs(low-1304.0) > epsilon {
+		t.Errorf("Interval(1.96) low = %v, want %v", low, 1304.0)
+	}
+	if math.Abs(high-1696.0) > epsilon {
+		t.Errorf("Interval(1.96) high = %v, want %v", high, 1696.0)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	p := Player{Id: "p", R: 1800, Rd: 30, Sigma: 0.06}
+	pool := []Player{
+		{Id: "a", R: 1500, Rd: 30, Sigma: 0.06},
+		{Id: "b", R: 1500, Rd: 30, Sigma: 0.06},
+		{Id: "c", R: 1500, Rd: 30, Sigma: 0.06},
+	}
+
+	if pct := Percentile(pool, p); pct <= 0.5 {
+		t.Errorf("Percentile(pool, p) = %v, want > 0.5 for a much stronger player", pct)
+	}
+
+	if pct := Percentile(nil, p); pct != 0 {
+		t.Errorf("Percentile(nil, p) = %v, want 0", pct)
 	}
 }
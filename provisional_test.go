@@ -0,0 +1,73 @@
+package glocko2
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRankProvisionalFastPath(t *testing.T) {
+	p := Player{Id: "p", R: 1500, Rd: 350, Sigma: 0.06, Games: 0}
+	players := []Player{{Id: "opp", R: 1500, Rd: 350, Sigma: 0.06}}
+	opponents := []Opponent{{Idx: 0, Sj: 1.0}}
+
+	const expectR, expectRd, expectSigma = 1517.5, 343.0, 0.06
+
+	r1, rd1, sigma1 := p.Rank(opponents, players, 0.5)
+
+	if math.Abs(r1-expectR) > epsilon {
+		t.Errorf("Rank() provisional r1 = %v, want %v", r1, expectR)
+	}
+	if math.Abs(rd1-expectRd) > epsilon {
+		t.Errorf("Rank() provisional rd1 = %v, want %v", rd1, expectRd)
+	}
+	if sigma1 != expectSigma {
+		t.Errorf("Rank() provisional sigma1 = %v, want unchanged %v", sigma1, expectSigma)
+	}
+}
+
+func TestRankSwitchesToFullAlgorithmPastThreshold(t *testing.T) {
+	// Same inputs as the reference example from Glickman's paper, but
+	// with enough games recorded that Rank must take the full path
+	// rather than the provisional fast path.
+	const expectR, expectRd, expectSigma = 1464.0506705393013, 151.51652412385727, 0.059995984286488495
+
+	p := Player{Id: "p", R: 1500, Rd: 200, Sigma: 0.06, Games: DefaultProvisionalGames}
+	players := []Player{
+		{Id: "a", R: 1400, Rd: 30},
+		{Id: "b", R: 1550, Rd: 100},
+		{Id: "c", R: 1700, Rd: 300},
+	}
+	opponents := []Opponent{
+		{Idx: 0, Sj: 1.0},
+		{Idx: 1, Sj: 0.0},
+		{Idx: 2, Sj: 0.0},
+	}
+
+	r1, rd1, sigmap := p.Rank(opponents, players, 0.5)
+
+	if math.Abs(r1-expectR) > epsilon {
+		t.Errorf("Rank() r1 = %v, want %v", r1, expectR)
+	}
+	if math.Abs(rd1-expectRd) > epsilon {
+		t.Errorf("Rank() rd1 = %v, want %v", rd1, expectRd)
+	}
+	if math.Abs(sigmap-expectSigma) > epsilon {
+		t.Errorf("Rank() sigmap = %v, want %v", sigmap, expectSigma)
+	}
+}
+
+func TestRankProvisionalRdShrinksTowardThreshold(t *testing.T) {
+	p := Player{Id: "p", R: 1500, Rd: 400, Sigma: 0.06, Games: 0}
+	players := []Player{{Id: "opp", R: 1500, Rd: 200, Sigma: 0.06}}
+	opponents := []Opponent{{Idx: 0, Sj: 1.0}}
+
+	for i := 0; i < 50 && (p.Rd > ProvisionalRdThreshold || p.Games < ProvisionalGames); i++ {
+		r1, rd1, sigma1 := p.Rank(opponents, players, 0.5)
+		p.R, p.Rd, p.Sigma = r1, rd1, sigma1
+		p.Games++
+	}
+
+	if p.Rd >= 400 {
+		t.Errorf("p.Rd = %v, want it to have shrunk from 400 after repeated provisional games", p.Rd)
+	}
+}
@@ -0,0 +1,83 @@
+package glocko2
+
+import (
+	"fmt"
+)
+
+// Match represents a single game played between two players during a
+// rating period, from PlayerA's perspective: Score is 1 for a win, 0
+// for a loss, and 0.5 for a draw.
+type Match struct {
+	PlayerA, PlayerB string
+	Score            float64
+}
+
+// RatingPeriod batches up all the matches played by a pool of players
+// over a single rating period, so that every player's update uses the
+// *pre-period* ratings of their opponents rather than ratings that have
+// already moved within the same period.
+type RatingPeriod struct {
+	// Tau is the system constant constraining volatility over time, as
+	// passed to Rank.
+	Tau float64
+	// Players is the pool of players taking part in this rating period.
+	Players []Player
+	// Matches is every game played within the period.
+	Matches []Match
+}
+
+// Run processes a rating period and returns the updated players. A
+// player with no matches in the period is treated as inactive: their R
+// and Sigma are left untouched, but their Rd grows via PhiStar so the
+// system doesn't overstate its confidence in a rating it hasn't seen
+// exercised. Run returns an error if a match references a player id not
+// present in Players.
+func (rp *RatingPeriod) Run() ([]Player, error) {
+	index := make(map[string]int, len(rp.Players))
+	for i, p := range rp.Players {
+		index[p.Id] = i
+	}
+
+	opponents := make(map[string][]Opponent, len(rp.Players))
+	for _, m := range rp.Matches {
+		ia, ok := index[m.PlayerA]
+		if !ok {
+			return nil, fmt.Errorf("glocko2: rating period match references unknown player %q", m.PlayerA)
+		}
+		ib, ok := index[m.PlayerB]
+		if !ok {
+			return nil, fmt.Errorf("glocko2: rating period match references unknown player %q", m.PlayerB)
+		}
+
+		opponents[m.PlayerA] = append(opponents[m.PlayerA], Opponent{Idx: ib, Sj: m.Score})
+		opponents[m.PlayerB] = append(opponents[m.PlayerB], Opponent{Idx: ia, Sj: 1 - m.Score})
+	}
+
+	pre := make([]Player, len(rp.Players))
+	copy(pre, rp.Players)
+
+	result := make([]Player, len(rp.Players))
+	copy(result, rp.Players)
+
+	for i, p := range pre {
+		os, played := opponents[p.Id]
+		if !played {
+			mu, phi := Scale(p.R, p.Rd)
+			phistar := PhiStar(p.Sigma, phi)
+			_, rd1 := Unscale(mu, phistar)
+
+			result[i].Rd = rd1
+			result[i].Active = false
+			continue
+		}
+
+		r1, rd1, sigma1 := p.Rank(os, pre, rp.Tau)
+		result[i].R = r1
+		result[i].Rd = rd1
+		result[i].Sigma = sigma1
+		result[i].Active = true
+		result[i].Games = p.Games + len(os)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package glocko2
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRatingPeriodRun(t *testing.T) {
+	rp := RatingPeriod{
+		Tau: 0.5,
+		Players: []Player{
+			{Id: "p", R: 1500, Rd: 200, Sigma: 0.06, Games: DefaultProvisionalGames},
+			{Id: "a", R: 1400, Rd: 30, Sigma: 0.06},
+			{Id: "b", R: 1550, Rd: 100, Sigma: 0.06},
+			{Id: "c", R: 1700, Rd: 300, Sigma: 0.06},
+			{Id: "idle", R: 1500, Rd: 200, Sigma: 0.06},
+		},
+		Matches: []Match{
+			{PlayerA: "p", PlayerB: "a", Score: 1.0},
+			{PlayerA: "p", PlayerB: "b", Score: 0.0},
+			{PlayerA: "p", PlayerB: "c", Score: 0.0},
+		},
+	}
+
+	result, err := rp.Run()
+	if err != nil {
+		t.Fatalf("Run() returned error: %v", err)
+	}
+
+	const expectR, expectRd, expectSigma = 1464.0506705393013, 151.51652412385727, 0.059995984286488495
+	p := result[0]
+	if math.Abs(p.R-expectR) > epsilon {
+		t.Errorf("p.R = %v, want %v", p.R, expectR)
+	}
+	if math.Abs(p.Rd-expectRd) > epsilon {
+		t.Errorf("p.Rd = %v, want %v", p.Rd, expectRd)
+	}
+	if math.Abs(p.Sigma-expectSigma) > epsilon {
+		t.Errorf("p.Sigma = %v, want %v", p.Sigma, expectSigma)
+	}
+	if !p.Active {
+		t.Errorf("p.Active = false, want true after playing matches")
+	}
+
+	idle := result[4]
+	if idle.R != 1500 || idle.Sigma != 0.06 {
+		t.Errorf("idle player's R/Sigma changed unexpectedly: %+v", idle)
+	}
+	if idle.Rd <= 200 {
+		t.Errorf("idle.Rd = %v, want > 200 (RD should grow while inactive)", idle.Rd)
+	}
+	if idle.Active {
+		t.Errorf("idle.Active = true, want false for a player with no matches")
+	}
+
+	// Opponents' ratings used during a's update must be the pre-period
+	// ones, unaffected by p's own update within the period.
+	a := result[1]
+	if a.R == 1400 {
+		t.Errorf("a.R unchanged, want a to have been updated from its match against p")
+	}
+	preA := rp.Players[1]
+	wantAR, _, _ := preA.Rank([]Opponent{{Idx: 0, Sj: 0.0}}, rp.Players, rp.Tau)
+	if math.Abs(a.R-wantAR) > epsilon {
+		t.Errorf("a.R = %v, want %v (rated against p's pre-period rating)", a.R, wantAR)
+	}
+}
+
+func TestRatingPeriodUnknownPlayer(t *testing.T) {
+	rp := RatingPeriod{
+		Tau:     0.5,
+		Players: []Player{{Id: "p", R: 1500, Rd: 200, Sigma: 0.06}},
+		Matches: []Match{{PlayerA: "p", PlayerB: "ghost", Score: 1.0}},
+	}
+
+	if _, err := rp.Run(); err == nil {
+		t.Errorf("Run() with an unknown opponent id, want an error")
+	}
+}
@@ -0,0 +1,94 @@
+package glocko2
+
+import (
+	"time"
+)
+
+// Registry wraps a Store with the operations a live matchmaking service
+// actually needs: recording match results and keeping rating history
+// flushed without the caller having to remember to call Snapshot.
+type Registry struct {
+	store      Store
+	tau        float64
+	flushEvery time.Duration
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+// NewRegistry wraps store, using tau for all rating updates. If
+// flushEvery is positive, a background goroutine calls Flush on that
+// interval until Close is called.
+func NewRegistry(store Store, tau float64, flushEvery time.Duration) *Registry {
+	r := &Registry{
+		store:      store,
+		tau:        tau,
+		flushEvery: flushEvery,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+
+	if flushEvery > 0 {
+		go r.flushLoop()
+	} else {
+		close(r.done)
+	}
+
+	return r
+}
+
+func (r *Registry) flushLoop() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.flushEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.Flush()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// RegisterMatch rates a single match between idA and idB, where scoreA
+// is idA's result (1 for a win, 0 for a loss, 0.5 for a draw), and
+// persists both players' updated ratings via the underlying Store.
+func (r *Registry) RegisterMatch(idA, idB string, scoreA float64) error {
+	a, err := r.store.Load(idA)
+	if err != nil {
+		return err
+	}
+	b, err := r.store.Load(idB)
+	if err != nil {
+		return err
+	}
+
+	pre := []Player{a, b}
+	ra, rda, sigmaa := a.Rank([]Opponent{{Idx: 1, Sj: scoreA}}, pre, r.tau)
+	rb, rdb, sigmab := b.Rank([]Opponent{{Idx: 0, Sj: 1 - scoreA}}, pre, r.tau)
+
+	a.R, a.Rd, a.Sigma = ra, rda, sigmaa
+	b.R, b.Rd, b.Sigma = rb, rdb, sigmab
+	a.Games++
+	b.Games++
+
+	if err := r.store.Save(a); err != nil {
+		return err
+	}
+	return r.store.Save(b)
+}
+
+// Flush records a rating-history snapshot of every player in the Store.
+func (r *Registry) Flush() error {
+	return r.store.Snapshot()
+}
+
+// Close stops the background flush goroutine (if one was started) and
+// performs one final flush before returning.
+func (r *Registry) Close() error {
+	close(r.stop)
+	<-r.done
+	return r.Flush()
+}
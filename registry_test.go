@@ -0,0 +1,73 @@
+package glocko2
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegistryRegisterMatch(t *testing.T) {
+	store := NewMemoryStore()
+	store.Save(Player{Id: "a", R: 1500, Rd: 200, Sigma: 0.06})
+	store.Save(Player{Id: "b", R: 1500, Rd: 200, Sigma: 0.06})
+
+	reg := NewRegistry(store, 0.5, 0)
+	defer reg.Close()
+
+	if err := reg.RegisterMatch("a", "b", 1.0); err != nil {
+		t.Fatalf("RegisterMatch returned error: %v", err)
+	}
+
+	a, err := store.Load("a")
+	if err != nil {
+		t.Fatalf("Load(\"a\") returned error: %v", err)
+	}
+	b, err := store.Load("b")
+	if err != nil {
+		t.Fatalf("Load(\"b\") returned error: %v", err)
+	}
+
+	if a.R <= 1500 {
+		t.Errorf("winner a.R = %v, want > 1500", a.R)
+	}
+	if b.R >= 1500 {
+		t.Errorf("loser b.R = %v, want < 1500", b.R)
+	}
+}
+
+func TestRegistryFlushRecordsSnapshot(t *testing.T) {
+	store := NewMemoryStore()
+	store.Save(Player{Id: "a", R: 1500, Rd: 200, Sigma: 0.06})
+
+	reg := NewRegistry(store, 0.5, 0)
+	defer reg.Close()
+
+	if err := reg.Flush(); err != nil {
+		t.Fatalf("Flush() returned error: %v", err)
+	}
+
+	hist, err := store.History("a")
+	if err != nil {
+		t.Fatalf("History(\"a\") returned error: %v", err)
+	}
+	if len(hist) != 1 {
+		t.Errorf("History(\"a\") = %v entries, want 1", len(hist))
+	}
+}
+
+func TestRegistryBackgroundFlush(t *testing.T) {
+	store := NewMemoryStore()
+	store.Save(Player{Id: "a", R: 1500, Rd: 200, Sigma: 0.06})
+
+	reg := NewRegistry(store, 0.5, 10*time.Millisecond)
+
+	time.Sleep(50 * time.Millisecond)
+	reg.Close()
+
+	hist, err := store.History("a")
+	if err != nil {
+		t.Fatalf("History(\"a\") returned error: %v", err)
+	}
+	if len(hist) == 0 {
+		t.Errorf("History(\"a\") = 0 entries, want at least one background flush to have run")
+	}
+}
@@ -0,0 +1,35 @@
+package glocko2
+
+import (
+	"time"
+)
+
+// RatingSnapshot records a player's rating at a point in time, so that a
+// caller can reconstruct a rating-history chart from a series of them.
+type RatingSnapshot struct {
+	Timestamp time.Time
+	R         float64
+	RD        float64
+	Sigma     float64
+}
+
+// Store is the persistence boundary for player records. Implementations
+// back it with whatever medium is appropriate for the deployment: an
+// in-memory map for tests, a JSON file for small deployments, or a
+// database for anything that needs to scale or survive a restart
+// without re-reading a flat file.
+type Store interface {
+	// Load retrieves a player by id. Implementations return an error if
+	// no such player exists.
+	Load(id string) (Player, error)
+	// Save persists a player record, creating it if it doesn't already
+	// exist.
+	Save(p Player) error
+	// List returns every player currently known to the store.
+	List() ([]Player, error)
+	// Snapshot records the current (R, RD, Sigma) of every known player,
+	// timestamped, for later retrieval via History.
+	Snapshot() error
+	// History returns the recorded snapshots for a player, oldest first.
+	History(id string) ([]RatingSnapshot, error)
+}
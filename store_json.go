@@ -0,0 +1,111 @@
+package glocko2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+type jsonStoreState struct {
+	Players   map[string]Player
+	Snapshots map[string][]RatingSnapshot
+}
+
+// JSONFileStore is a Store backed by a single JSON file on disk. It's
+// meant for small deployments that want player records to survive a
+// restart without the operational overhead of a database.
+type JSONFileStore struct {
+	mu    sync.Mutex
+	path  string
+	state jsonStoreState
+}
+
+// NewJSONFileStore opens (or creates) a JSON file store at path.
+func NewJSONFileStore(path string) (*JSONFileStore, error) {
+	s := &JSONFileStore{
+		path: path,
+		state: jsonStoreState{
+			Players:   make(map[string]Player),
+			Snapshots: make(map[string][]RatingSnapshot),
+		},
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.state); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *JSONFileStore) persistLocked() error {
+	data, err := json.MarshalIndent(s.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0644)
+}
+
+func (s *JSONFileStore) Load(id string) (Player, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.state.Players[id]
+	if !ok {
+		return Player{}, fmt.Errorf("glocko2: no such player %q", id)
+	}
+	return p, nil
+}
+
+func (s *JSONFileStore) Save(p Player) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state.Players[p.Id] = p
+	return s.persistLocked()
+}
+
+func (s *JSONFileStore) List() ([]Player, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]Player, 0, len(s.state.Players))
+	for _, p := range s.state.Players {
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+func (s *JSONFileStore) Snapshot() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for id, p := range s.state.Players {
+		s.state.Snapshots[id] = append(s.state.Snapshots[id], RatingSnapshot{
+			Timestamp: now,
+			R:         p.R,
+			RD:        p.Rd,
+			Sigma:     p.Sigma,
+		})
+	}
+	return s.persistLocked()
+}
+
+func (s *JSONFileStore) History(id string) ([]RatingSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state.Players[id]; !ok {
+		return nil, fmt.Errorf("glocko2: no such player %q", id)
+	}
+	return append([]RatingSnapshot(nil), s.state.Snapshots[id]...), nil
+}
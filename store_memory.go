@@ -0,0 +1,79 @@
+package glocko2
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// MemoryStore is an in-memory Store, suitable for tests and for
+// deployments that don't need player records to survive a restart.
+type MemoryStore struct {
+	mu        sync.Mutex
+	players   map[string]Player
+	snapshots map[string][]RatingSnapshot
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		players:   make(map[string]Player),
+		snapshots: make(map[string][]RatingSnapshot),
+	}
+}
+
+func (s *MemoryStore) Load(id string) (Player, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.players[id]
+	if !ok {
+		return Player{}, fmt.Errorf("glocko2: no such player %q", id)
+	}
+	return p, nil
+}
+
+func (s *MemoryStore) Save(p Player) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.players[p.Id] = p
+	return nil
+}
+
+func (s *MemoryStore) List() ([]Player, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]Player, 0, len(s.players))
+	for _, p := range s.players {
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+func (s *MemoryStore) Snapshot() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for id, p := range s.players {
+		s.snapshots[id] = append(s.snapshots[id], RatingSnapshot{
+			Timestamp: now,
+			R:         p.R,
+			RD:        p.Rd,
+			Sigma:     p.Sigma,
+		})
+	}
+	return nil
+}
+
+func (s *MemoryStore) History(id string) ([]RatingSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.players[id]; !ok {
+		return nil, fmt.Errorf("glocko2: no such player %q", id)
+	}
+	return append([]RatingSnapshot(nil), s.snapshots[id]...), nil
+}
@@ -0,0 +1,141 @@
+//go:build sqlite
+
+package glocko2
+
+// The SQLite-backed Store is gated behind the "sqlite" build tag because
+// it pulls in a cgo dependency (mattn/go-sqlite3). Build with
+// `-tags sqlite` to include it.
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// SQLiteStore is a Store backed by a SQLite database, for deployments
+// that want player records and rating history without running a
+// separate database server. Save replaces a player's previous record;
+// earlier ratings are kept only in the rows written by Snapshot.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (and if necessary initializes) a SQLite database
+// at path as a Store.
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+
+	const schema = `
+CREATE TABLE IF NOT EXISTS players (
+	id     TEXT PRIMARY KEY,
+	name   TEXT NOT NULL,
+	r      REAL NOT NULL,
+	rd     REAL NOT NULL,
+	sigma  REAL NOT NULL,
+	active BOOLEAN NOT NULL,
+	games  INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS snapshots (
+	player_id TEXT NOT NULL,
+	ts        DATETIME NOT NULL,
+	r         REAL NOT NULL,
+	rd        REAL NOT NULL,
+	sigma     REAL NOT NULL
+);
+`
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+func (s *SQLiteStore) Load(id string) (Player, error) {
+	row := s.db.QueryRow(`SELECT id, name, r, rd, sigma, active, games FROM players WHERE id = ?`, id)
+
+	var p Player
+	if err := row.Scan(&p.Id, &p.Name, &p.R, &p.Rd, &p.Sigma, &p.Active, &p.Games); err != nil {
+		if err == sql.ErrNoRows {
+			return Player{}, fmt.Errorf("glocko2: no such player %q", id)
+		}
+		return Player{}, err
+	}
+	return p, nil
+}
+
+func (s *SQLiteStore) Save(p Player) error {
+	_, err := s.db.Exec(`
+INSERT INTO players (id, name, r, rd, sigma, active, games) VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET name = excluded.name, r = excluded.r, rd = excluded.rd,
+	sigma = excluded.sigma, active = excluded.active, games = excluded.games`,
+		p.Id, p.Name, p.R, p.Rd, p.Sigma, p.Active, p.Games)
+	return err
+}
+
+func (s *SQLiteStore) List() ([]Player, error) {
+	rows, err := s.db.Query(`SELECT id, name, r, rd, sigma, active, games FROM players`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []Player
+	for rows.Next() {
+		var p Player
+		if err := rows.Scan(&p.Id, &p.Name, &p.R, &p.Rd, &p.Sigma, &p.Active, &p.Games); err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+	return res, rows.Err()
+}
+
+func (s *SQLiteStore) Snapshot() error {
+	players, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, p := range players {
+		if _, err := tx.Exec(`INSERT INTO snapshots (player_id, ts, r, rd, sigma) VALUES (?, ?, ?, ?, ?)`,
+			p.Id, now, p.R, p.Rd, p.Sigma); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *SQLiteStore) History(id string) ([]RatingSnapshot, error) {
+	rows, err := s.db.Query(`SELECT ts, r, rd, sigma FROM snapshots WHERE player_id = ? ORDER BY ts ASC`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []RatingSnapshot
+	for rows.Next() {
+		var snap RatingSnapshot
+		if err := rows.Scan(&snap.Timestamp, &snap.R, &snap.RD, &snap.Sigma); err != nil {
+			return nil, err
+		}
+		res = append(res, snap)
+	}
+	return res, rows.Err()
+}
+
+// Close releases the underlying database handle.
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
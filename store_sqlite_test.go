@@ -0,0 +1,87 @@
+//go:build sqlite
+
+package glocko2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSQLiteStorePersistsAcrossInstances(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "players.db")
+
+	s1, err := NewSQLiteStore(path)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore returned error: %v", err)
+	}
+	defer s1.Close()
+
+	p := Player{Id: "p", R: 1500, Rd: 200, Sigma: 0.06, Games: 5}
+	if err := s1.Save(p); err != nil {
+		t.Fatalf("Save(p) returned error: %v", err)
+	}
+	if err := s1.Snapshot(); err != nil {
+		t.Fatalf("Snapshot() returned error: %v", err)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected %s to exist after Save: %v", path, err)
+	}
+
+	got, err := s1.Load("p")
+	if err != nil {
+		t.Fatalf("Load(\"p\") returned error: %v", err)
+	}
+	if got != p {
+		t.Errorf("Load(\"p\") = %+v, want %+v", got, p)
+	}
+
+	list, err := s1.List()
+	if err != nil {
+		t.Fatalf("List() returned error: %v", err)
+	}
+	if len(list) != 1 {
+		t.Errorf("List() = %v players, want 1", len(list))
+	}
+
+	hist, err := s1.History("p")
+	if err != nil {
+		t.Fatalf("History(\"p\") returned error: %v", err)
+	}
+	if len(hist) != 1 {
+		t.Errorf("History(\"p\") = %v entries, want 1", len(hist))
+	}
+	if hist[0].R != p.R || hist[0].RD != p.Rd || hist[0].Sigma != p.Sigma {
+		t.Errorf("History(\"p\")[0] = %+v, want R/RD/Sigma matching %+v", hist[0], p)
+	}
+	s1.Close()
+
+	s2, err := NewSQLiteStore(path)
+	if err != nil {
+		t.Fatalf("re-opening SQLiteStore returned error: %v", err)
+	}
+	defer s2.Close()
+
+	got2, err := s2.Load("p")
+	if err != nil {
+		t.Fatalf("Load(\"p\") on reopened store returned error: %v", err)
+	}
+	if got2 != p {
+		t.Errorf("Load(\"p\") on reopened store = %+v, want %+v", got2, p)
+	}
+}
+
+func TestSQLiteStoreLoadUnknownPlayer(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewSQLiteStore(filepath.Join(dir, "players.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteStore returned error: %v", err)
+	}
+	defer s.Close()
+
+	if _, err := s.Load("ghost"); err == nil {
+		t.Errorf("Load(\"ghost\") on empty store, want an error")
+	}
+}
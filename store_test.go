@@ -0,0 +1,105 @@
+package glocko2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMemoryStoreLoadSave(t *testing.T) {
+	s := NewMemoryStore()
+
+	if _, err := s.Load("p"); err == nil {
+		t.Errorf("Load(\"p\") on empty store, want an error")
+	}
+
+	p := Player{Id: "p", R: 1500, Rd: 200, Sigma: 0.06}
+	if err := s.Save(p); err != nil {
+		t.Fatalf("Save(p) returned error: %v", err)
+	}
+
+	got, err := s.Load("p")
+	if err != nil {
+		t.Fatalf("Load(\"p\") returned error: %v", err)
+	}
+	if got != p {
+		t.Errorf("Load(\"p\") = %+v, want %+v", got, p)
+	}
+
+	list, err := s.List()
+	if err != nil {
+		t.Fatalf("List() returned error: %v", err)
+	}
+	if len(list) != 1 {
+		t.Errorf("List() = %v players, want 1", len(list))
+	}
+}
+
+func TestMemoryStoreSnapshotHistory(t *testing.T) {
+	s := NewMemoryStore()
+	p := Player{Id: "p", R: 1500, Rd: 200, Sigma: 0.06}
+	if err := s.Save(p); err != nil {
+		t.Fatalf("Save(p) returned error: %v", err)
+	}
+
+	if err := s.Snapshot(); err != nil {
+		t.Fatalf("Snapshot() returned error: %v", err)
+	}
+	if err := s.Snapshot(); err != nil {
+		t.Fatalf("Snapshot() returned error: %v", err)
+	}
+
+	hist, err := s.History("p")
+	if err != nil {
+		t.Fatalf("History(\"p\") returned error: %v", err)
+	}
+	if len(hist) != 2 {
+		t.Errorf("History(\"p\") = %v entries, want 2", len(hist))
+	}
+	if hist[0].R != p.R || hist[0].RD != p.Rd || hist[0].Sigma != p.Sigma {
+		t.Errorf("History(\"p\")[0] = %+v, want R/RD/Sigma matching %+v", hist[0], p)
+	}
+}
+
+func TestJSONFileStorePersistsAcrossInstances(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "players.json")
+
+	s1, err := NewJSONFileStore(path)
+	if err != nil {
+		t.Fatalf("NewJSONFileStore returned error: %v", err)
+	}
+
+	p := Player{Id: "p", R: 1500, Rd: 200, Sigma: 0.06}
+	if err := s1.Save(p); err != nil {
+		t.Fatalf("Save(p) returned error: %v", err)
+	}
+	if err := s1.Snapshot(); err != nil {
+		t.Fatalf("Snapshot() returned error: %v", err)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected %s to exist after Save: %v", path, err)
+	}
+
+	s2, err := NewJSONFileStore(path)
+	if err != nil {
+		t.Fatalf("re-opening JSONFileStore returned error: %v", err)
+	}
+
+	got, err := s2.Load("p")
+	if err != nil {
+		t.Fatalf("Load(\"p\") on reopened store returned error: %v", err)
+	}
+	if got != p {
+		t.Errorf("Load(\"p\") on reopened store = %+v, want %+v", got, p)
+	}
+
+	hist, err := s2.History("p")
+	if err != nil {
+		t.Fatalf("History(\"p\") on reopened store returned error: %v", err)
+	}
+	if len(hist) != 1 {
+		t.Errorf("History(\"p\") on reopened store = %v entries, want 1", len(hist))
+	}
+}